@@ -5,16 +5,19 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/pterm/pterm/internal"
 )
 
 var DefaultMultiPrinter = MultiPrinter{
 	printers:    []LivePrinter{},
 	Writer:      os.Stdout,
 	UpdateDelay: time.Millisecond * 200,
-	buffers:     []*syncBuffer{},
+	buffers:     []*multiBuffer{},
 	area:        DefaultArea,
 }
 
@@ -36,15 +39,82 @@ func (sb *syncBuffer) String() string {
 	return sb.buf.String()
 }
 
+// multiBuffer is a single child writer tracked by a MultiPrinter, along with
+// the bookkeeping needed for dynamic AddWriter/RemoveWriter and priority
+// ordering.
+type multiBuffer struct {
+	id        int
+	order     int
+	priority  int
+	dropOnEOF bool
+	buf       *syncBuffer
+}
+
+// MultiPrinterWriterOption configures a writer added via AddWriter.
+type MultiPrinterWriterOption func(*multiBuffer)
+
+// WithPriority sets the priority used to order a writer's line relative to
+// the MultiPrinter's other writers: lines are rendered lowest priority
+// first, so a high-priority writer (e.g. an overall summary bar) can be
+// made to always render last. Writers with equal priority keep insertion
+// order.
+func WithPriority(priority int) MultiPrinterWriterOption {
+	return func(mb *multiBuffer) {
+		mb.priority = priority
+	}
+}
+
+// WithDropOnEOF makes the writer's buffer removed from the MultiPrinter as
+// soon as it is closed via the Close method on the writer returned by
+// AddWriter.
+func WithDropOnEOF(b ...bool) MultiPrinterWriterOption {
+	return func(mb *multiBuffer) {
+		mb.dropOnEOF = internal.WithBoolean(b)
+	}
+}
+
+// multiWriter is the io.WriteCloser returned by AddWriter. Closing it
+// removes its buffer from the MultiPrinter when the writer was added with
+// WithDropOnEOF.
+type multiWriter struct {
+	p   *MultiPrinter
+	id  int
+	buf *syncBuffer
+}
+
+func (w *multiWriter) Write(b []byte) (int, error) {
+	return w.buf.Write(b)
+}
+
+func (w *multiWriter) Close() error {
+	w.p.mu.RLock()
+	dropOnEOF := false
+	for _, mb := range w.p.buffers {
+		if mb.id == w.id {
+			dropOnEOF = mb.dropOnEOF
+			break
+		}
+	}
+	w.p.mu.RUnlock()
+
+	if dropOnEOF {
+		w.p.RemoveWriter(w.id)
+	}
+	return nil
+}
+
 type MultiPrinter struct {
 	IsActive    bool
 	Writer      io.Writer
 	UpdateDelay time.Duration
 
-	mu       sync.RWMutex // protects printers and buffers
-	printers []LivePrinter
-	buffers  []*syncBuffer
-	area     AreaPrinter
+	mu            sync.RWMutex // protects printers, buffers and the counters/line tracking below
+	printers      []LivePrinter
+	buffers       []*multiBuffer
+	nextWriterID  int
+	nextWriterPos int
+	lastLineCount int
+	area          AreaPrinter
 }
 
 func (p *MultiPrinter) SetWriter(writer io.Writer) {
@@ -63,22 +133,70 @@ func (p MultiPrinter) WithUpdateDelay(delay time.Duration) *MultiPrinter {
 	return &p
 }
 
+// NewWriter registers a child writer with the MultiPrinter. Deprecated in
+// favor of AddWriter, which also returns the writer's id and accepts
+// ordering/lifecycle options; kept for backwards compatibility.
 func (p *MultiPrinter) NewWriter() io.Writer {
+	_, w := p.AddWriter()
+	return w
+}
+
+// AddWriter registers a new child writer with the MultiPrinter and returns
+// its id (for later RemoveWriter calls) along with the writer itself. It is
+// safe to call at any time, including while the MultiPrinter's update
+// goroutine is running after Start.
+//
+// The returned writer also implements io.Closer; closing it removes it from
+// the MultiPrinter if it was added with WithDropOnEOF.
+func (p *MultiPrinter) AddWriter(opts ...MultiPrinterWriterOption) (int, io.Writer) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	buf := &syncBuffer{}
-	p.buffers = append(p.buffers, buf)
-	return buf
+	id := p.nextWriterID
+	p.nextWriterID++
+	order := p.nextWriterPos
+	p.nextWriterPos++
+
+	mb := &multiBuffer{id: id, order: order, buf: &syncBuffer{}}
+	for _, opt := range opts {
+		opt(mb)
+	}
+	p.buffers = append(p.buffers, mb)
+
+	return id, &multiWriter{p: p, id: id, buf: mb.buf}
+}
+
+// RemoveWriter removes the writer with the given id from the MultiPrinter,
+// so it stops being rendered. It is safe to call while the MultiPrinter's
+// update goroutine is running. Removing an unknown id is a no-op.
+func (p *MultiPrinter) RemoveWriter(id int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, mb := range p.buffers {
+		if mb.id == id {
+			p.buffers = append(p.buffers[:i], p.buffers[i+1:]...)
+			break
+		}
+	}
 }
 
 func (p *MultiPrinter) getString() string {
 	p.mu.RLock()
-	defer p.mu.RUnlock()
+	sorted := make([]*multiBuffer, len(p.buffers))
+	copy(sorted, p.buffers)
+	p.mu.RUnlock()
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].priority != sorted[j].priority {
+			return sorted[i].priority < sorted[j].priority
+		}
+		return sorted[i].order < sorted[j].order
+	})
 
 	var buffer bytes.Buffer
-	for _, b := range p.buffers {
-		s := b.String()
+	for _, mb := range sorted {
+		s := mb.buf.String()
 		s = strings.Trim(s, "\n")
 
 		parts := strings.Split(s, "\r") // only get the last override
@@ -96,6 +214,23 @@ func (p *MultiPrinter) getString() string {
 	return buffer.String()
 }
 
+// render returns the current frame, padded with trailing blank lines when
+// the visible set has shrunk since the last frame, so the extra rows get
+// cleared by area.Update instead of lingering on screen.
+func (p *MultiPrinter) render() string {
+	rendered := p.getString()
+	lineCount := strings.Count(rendered, "\n")
+
+	p.mu.Lock()
+	if lineCount < p.lastLineCount {
+		rendered += strings.Repeat("\n", p.lastLineCount-lineCount)
+	}
+	p.lastLineCount = lineCount
+	p.mu.Unlock()
+
+	return rendered
+}
+
 func (p *MultiPrinter) Start() (*MultiPrinter, error) {
 	p.mu.Lock()
 	p.IsActive = true
@@ -113,7 +248,7 @@ func (p *MultiPrinter) Start() (*MultiPrinter, error) {
 			return false
 		}
 
-		p.area.Update(p.getString())
+		p.area.Update(p.render())
 		return true
 	})
 
@@ -129,7 +264,7 @@ func (p *MultiPrinter) Stop() (*MultiPrinter, error) {
 	p.mu.Unlock()
 
 	time.Sleep(time.Millisecond * 20)
-	p.area.Update(p.getString())
+	p.area.Update(p.render())
 	p.area.Stop()
 
 	return p, nil
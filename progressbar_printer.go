@@ -9,9 +9,12 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"text/template"
 	"time"
 
 	"github.com/gookit/color"
+	"golang.org/x/term"
+
 	"github.com/pterm/pterm/internal"
 )
 
@@ -36,8 +39,52 @@ var DefaultProgressbar = ProgressbarPrinter{
 	BarFiller:                 Gray("█"),
 	MaxWidth:                  80,
 	Writer:                    os.Stdout,
+	Units:                     UnitsDefault,
+}
+
+// Units defines how the count and speed decorators of a ProgressbarPrinter
+// render their numbers.
+type Units int
+
+const (
+	// UnitsDefault renders plain numbers, e.g. "42".
+	UnitsDefault Units = iota
+	// UnitsBytes renders numbers as IEC byte sizes, e.g. "1.23 MiB".
+	UnitsBytes
+	// UnitsBytesSI renders numbers as SI byte sizes, e.g. "1.23 MB".
+	UnitsBytesSI
+	// UnitsDuration renders numbers as a duration, e.g. "00:12".
+	UnitsDuration
+)
+
+// ProgressState is a snapshot of a ProgressbarPrinter's values, passed to
+// Callback on every Render so embedders can build arbitrary UIs without
+// parsing the rendered string.
+type ProgressState struct {
+	Current  int
+	Total    int
+	Elapsed  time.Duration
+	Speed    float64
+	ETA      time.Duration
+	Title    string
+	Finished bool
+}
+
+// progressSample is a single (time, current) observation used to estimate
+// transfer speed with an exponentially-weighted moving average.
+type progressSample struct {
+	time    time.Time
+	current int
 }
 
+// progressSpeedSamples is the size of the ring buffer of samples kept for
+// speed estimation.
+const progressSpeedSamples = 10
+
+// progressSpeedAlpha is the weight given to the most recent instantaneous
+// rate when updating the EWMA speed estimate.
+const progressSpeedAlpha = 0.25
+
 // ProgressbarPrinter shows a progress animation in the terminal.
 type ProgressbarPrinter struct {
 	mu                        sync.RWMutex
@@ -54,7 +101,43 @@ type ProgressbarPrinter struct {
 	ShowCount       bool
 	ShowTitle       bool
 	ShowPercentage  bool
+	ShowSpeed       bool
+	ShowTimeLeft    bool
 	RemoveWhenDone  bool
+	StopOnEOF       bool
+
+	// Units controls how the count and speed decorators render their
+	// numbers (plain, IEC bytes, SI bytes or duration).
+	Units Units
+
+	// Template, when non-empty, overrides the built-in layout with a
+	// text/template string built from named decorators. See
+	// RegisterProgressTemplateFunc and the ProgressTemplate* constants.
+	// A bar whose Total is zero always uses the indeterminate layout and
+	// ignores Template.
+	Template string
+
+	// templateMu guards parsedTemplate/parsedTemplateText independently of
+	// mu, since getString (and therefore the template cache lookup) only
+	// ever takes mu's read lock and concurrent renders must be able to
+	// parse/cache the template without racing each other.
+	templateMu         sync.Mutex
+	parsedTemplate     *template.Template
+	parsedTemplateText string
+	userStrings        map[string]string
+
+	// Manual disables the re-render scheduler and all cursor manipulation.
+	// The caller is responsible for calling Render() whenever a frame
+	// should be produced; useful for CI logs, library embedders forwarding
+	// state to a custom UI, and tests.
+	Manual bool
+
+	// Callback, if set, is invoked with the rendered frame and the current
+	// ProgressState on every Render, letting embedders build arbitrary UIs
+	// without parsing the rendered string.
+	Callback func(rendered string, state ProgressState)
+
+	lastNonTTYRender time.Time
 
 	TitleStyle *Style
 	BarStyle   *Style
@@ -64,6 +147,9 @@ type ProgressbarPrinter struct {
 	startedAt    time.Time
 	rerenderTask *schedule.Task
 
+	samples []progressSample
+	speed   float64
+
 	Writer io.Writer
 }
 
@@ -135,6 +221,24 @@ func (p ProgressbarPrinter) WithShowPercentage(b ...bool) *ProgressbarPrinter {
 	return &p
 }
 
+// WithShowSpeed sets if the transfer speed should be displayed in the ProgressbarPrinter.
+func (p ProgressbarPrinter) WithShowSpeed(b ...bool) *ProgressbarPrinter {
+	p.ShowSpeed = internal.WithBoolean(b)
+	return &p
+}
+
+// WithShowTimeLeft sets if the estimated remaining time (ETA) should be displayed in the ProgressbarPrinter.
+func (p ProgressbarPrinter) WithShowTimeLeft(b ...bool) *ProgressbarPrinter {
+	p.ShowTimeLeft = internal.WithBoolean(b)
+	return &p
+}
+
+// WithUnits sets the unit formatting used for the count and speed decorators.
+func (p ProgressbarPrinter) WithUnits(units Units) *ProgressbarPrinter {
+	p.Units = units
+	return &p
+}
+
 // WithStartedAt sets the time when the ProgressbarPrinter started.
 func (p ProgressbarPrinter) WithStartedAt(t time.Time) *ProgressbarPrinter {
 	p.startedAt = t
@@ -159,6 +263,47 @@ func (p ProgressbarPrinter) WithRemoveWhenDone(b ...bool) *ProgressbarPrinter {
 	return &p
 }
 
+// WithTemplate sets the text/template layout used to render the
+// ProgressbarPrinter, overriding the built-in layout.
+func (p ProgressbarPrinter) WithTemplate(tmpl string) *ProgressbarPrinter {
+	p.Template = tmpl
+	return &p
+}
+
+// Set stores a user-defined string under key, retrievable from a Template
+// via {{string . "key"}}.
+func (p *ProgressbarPrinter) Set(key, value string) *ProgressbarPrinter {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.userStrings == nil {
+		p.userStrings = make(map[string]string)
+	}
+	p.userStrings[key] = value
+	return p
+}
+
+// WithManual sets if the ProgressbarPrinter should disable its re-render
+// scheduler and cursor manipulation, leaving rendering entirely to explicit
+// Render() calls.
+func (p ProgressbarPrinter) WithManual(b ...bool) *ProgressbarPrinter {
+	p.Manual = internal.WithBoolean(b)
+	return &p
+}
+
+// WithCallback sets a function invoked with the rendered frame and current
+// ProgressState on every Render.
+func (p ProgressbarPrinter) WithCallback(fn func(rendered string, state ProgressState)) *ProgressbarPrinter {
+	p.Callback = fn
+	return &p
+}
+
+// WithStopOnEOF sets if a proxy reader/writer created via NewProxyReader or
+// NewProxyWriter should stop the ProgressbarPrinter once it observes EOF.
+func (p ProgressbarPrinter) WithStopOnEOF(b ...bool) *ProgressbarPrinter {
+	p.StopOnEOF = internal.WithBoolean(b)
+	return &p
+}
+
 // WithBarFiller sets the filler character for the ProgressbarPrinter.
 func (p ProgressbarPrinter) WithBarFiller(char string) *ProgressbarPrinter {
 	p.BarFiller = char
@@ -208,10 +353,93 @@ func (p *ProgressbarPrinter) UpdateTitle(title string) *ProgressbarPrinter {
 }
 
 func (p *ProgressbarPrinter) updateProgress() *ProgressbarPrinter {
-	Fprinto(p.Writer, p.getString())
+	p.mu.RLock()
+	manual := p.Manual
+	p.mu.RUnlock()
+	if manual {
+		return p
+	}
+	return p.Render()
+}
+
+// Render produces a single frame: it calls Callback, if set, with the
+// rendered string and the current ProgressState, and writes the frame to
+// Writer. Writer is auto-detected for TTY-ness; when it isn't a terminal
+// (e.g. a CI log), each written frame is its own newline-terminated line
+// instead of being overwritten with a carriage return, and outside Manual
+// mode unfinished frames are written at most once per
+// progressNonTTYRenderInterval.
+//
+// In Manual mode this is the only way a frame is produced — call it
+// whenever you want the bar's current state reflected.
+func (p *ProgressbarPrinter) Render() *ProgressbarPrinter {
+	rendered := p.getString()
+	state := p.State()
+
+	p.mu.Lock()
+	writer := p.Writer
+	callback := p.Callback
+	manual := p.Manual
+	isTTY := isTerminalWriter(writer)
+	shouldWrite := true
+	if !isTTY && !manual {
+		now := time.Now()
+		if !state.Finished && !p.lastNonTTYRender.IsZero() && now.Sub(p.lastNonTTYRender) < progressNonTTYRenderInterval {
+			shouldWrite = false
+		}
+		if shouldWrite {
+			p.lastNonTTYRender = now
+		}
+	}
+	p.mu.Unlock()
+
+	if callback != nil {
+		callback(rendered, state)
+	}
+
+	if rendered == "" || !shouldWrite {
+		return p
+	}
+
+	if isTTY {
+		Fprinto(writer, rendered)
+	} else {
+		// Deliberately not pterm's own Fprintln: that helper ends with a
+		// "refresh all active progress bars" loop which calls back into
+		// UpdateTitle/Render for every active bar, including this one —
+		// recursing forever once the bar is Finished, since a finished
+		// frame always bypasses the throttle above.
+		fmt.Fprintln(writer, rendered)
+	}
+
 	return p
 }
 
+// State returns a snapshot of the ProgressbarPrinter's current values.
+func (p *ProgressbarPrinter) State() ProgressState {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return ProgressState{
+		Current:  p.Current,
+		Total:    p.Total,
+		Elapsed:  time.Since(p.startedAt),
+		Speed:    p.speed,
+		ETA:      p.getETA(),
+		Title:    p.Title,
+		Finished: p.Total > 0 && p.Current >= p.Total,
+	}
+}
+
+// isTerminalWriter reports whether w is a terminal, for writers that are
+// *os.File (e.g. os.Stdout). Any other io.Writer is treated as non-TTY.
+func isTerminalWriter(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	return term.IsTerminal(int(f.Fd()))
+}
+
 func (p *ProgressbarPrinter) getString() string {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
@@ -226,7 +454,7 @@ func (p *ProgressbarPrinter) getString() string {
 		p.BarStyle = NewStyle()
 	}
 	if p.Total == 0 {
-		return ""
+		return p.getIndeterminateString()
 	}
 
 	var before string
@@ -241,12 +469,20 @@ func (p *ProgressbarPrinter) getString() string {
 		width = p.MaxWidth
 	}
 
+	if p.Template != "" {
+		return p.getTemplateString(width)
+	}
+
 	if p.ShowTitle {
 		before += p.TitleStyle.Sprint(p.Title) + " "
 	}
 	if p.ShowCount {
-		padding := 1 + int(math.Log10(float64(p.Total)))
-		before += Gray("[") + LightWhite(fmt.Sprintf("%0*d", padding, p.Current)) + Gray("/") + LightWhite(p.Total) + Gray("]") + " "
+		if p.Units != UnitsDefault {
+			before += Gray("[") + LightWhite(formatUnitValue(float64(p.Current), p.Units)) + Gray("/") + LightWhite(formatUnitValue(float64(p.Total), p.Units)) + Gray("]") + " "
+		} else {
+			padding := 1 + int(math.Log10(float64(p.Total)))
+			before += Gray("[") + LightWhite(fmt.Sprintf("%0*d", padding, p.Current)) + Gray("/") + LightWhite(p.Total) + Gray("]") + " "
+		}
 	}
 
 	after += " "
@@ -258,7 +494,13 @@ func (p *ProgressbarPrinter) getString() string {
 		after += decoratorCurrentPercentage + " "
 	}
 	if p.ShowElapsedTime {
-		after += "| " + p.parseElapsedTime()
+		after += "| " + p.parseElapsedTime() + " "
+	}
+	if p.ShowSpeed {
+		after += "| " + p.formatSpeed() + " "
+	}
+	if p.ShowTimeLeft {
+		after += "| ETA " + p.formatETA()
 	}
 
 	barMaxLength := width - len(RemoveColorFromString(before)) - len(RemoveColorFromString(after)) - 1
@@ -280,19 +522,15 @@ func (p *ProgressbarPrinter) getString() string {
 // Add to current value.
 func (p *ProgressbarPrinter) Add(count int) *ProgressbarPrinter {
 	p.mu.Lock()
-	if p.Total == 0 {
-		p.mu.Unlock()
-		return nil
-	}
-
 	p.Current += count
+	p.recordSample()
 	currentValue := p.Current
 	total := p.Total
 	p.mu.Unlock()
 
 	p.updateProgress()
 
-	if currentValue >= total {
+	if total > 0 && currentValue >= total {
 		p.mu.Lock()
 		p.Total = currentValue
 		p.mu.Unlock()
@@ -306,7 +544,9 @@ func (p *ProgressbarPrinter) Add(count int) *ProgressbarPrinter {
 func (p ProgressbarPrinter) Start(title ...any) (*ProgressbarPrinter, error) {
 	newP := &p // Create a new instance
 
-	cursor.Hide()
+	if !newP.Manual {
+		cursor.Hide()
+	}
 
 	newP.mu.Lock()
 	if RawOutput && newP.ShowTitle {
@@ -323,9 +563,11 @@ func (p ProgressbarPrinter) Start(title ...any) (*ProgressbarPrinter, error) {
 	ActiveProgressBarPrinters = append(ActiveProgressBarPrinters, newP)
 	activeProgressBarPrintersMu.Unlock()
 
-	newP.updateProgress()
+	if !newP.Manual {
+		newP.updateProgress()
+	}
 
-	if newP.ShowElapsedTime {
+	if newP.ShowElapsedTime && !newP.Manual {
 		newP.rerenderTask = schedule.Every(time.Second, func() bool {
 			if !newP.IsActive {
 				return false
@@ -351,17 +593,20 @@ func (p *ProgressbarPrinter) Stop() (*ProgressbarPrinter, error) {
 	}
 	p.IsActive = false
 
+	manual := p.Manual
 	removeWhenDone := p.RemoveWhenDone
 	writer := p.Writer
 	p.mu.Unlock()
 
-	cursor.Show()
+	if !manual {
+		cursor.Show()
 
-	if removeWhenDone {
-		fClearLine(writer)
-		Fprinto(writer)
-	} else {
-		Fprintln(writer)
+		if removeWhenDone {
+			fClearLine(writer)
+			Fprinto(writer)
+		} else {
+			Fprintln(writer)
+		}
 	}
 
 	activeProgressBarPrintersMu.Lock()
@@ -400,3 +645,140 @@ func (p *ProgressbarPrinter) GetElapsedTime() time.Duration {
 func (p *ProgressbarPrinter) parseElapsedTime() string {
 	return p.GetElapsedTime().Round(p.ElapsedTimeRoundingFactor).String()
 }
+
+// progressIndeterminateFrames are the spinner frames used to render a
+// ProgressbarPrinter whose Total is unknown.
+var progressIndeterminateFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// progressIndeterminateFrameDuration is how long each indeterminate spinner
+// frame is shown before advancing to the next one.
+const progressIndeterminateFrameDuration = 120 * time.Millisecond
+
+// progressNonTTYRenderInterval throttles Render's writes to a non-TTY
+// Writer (e.g. a CI log), so a tight Add/Increment loop produces a periodic
+// summary line instead of flooding the log with one line per call. It
+// matches the cadence of the elapsed-time re-render ticker in Start.
+const progressNonTTYRenderInterval = time.Second
+
+// getIndeterminateString renders a ProgressbarPrinter whose Total is zero:
+// a spinner, the raw count read so far and, optionally, the transfer speed
+// and elapsed time. There is no percentage or ETA, since the final size is
+// unknown. Callers must hold p.mu.
+func (p *ProgressbarPrinter) getIndeterminateString() string {
+	frame := progressIndeterminateFrames[int(time.Since(p.startedAt)/progressIndeterminateFrameDuration)%len(progressIndeterminateFrames)]
+
+	out := p.BarStyle.Sprint(frame) + " "
+	if p.ShowTitle {
+		out += p.TitleStyle.Sprint(p.Title) + " "
+	}
+	out += LightWhite(formatUnitValue(float64(p.Current), p.Units))
+	if p.ShowSpeed {
+		out += " | " + p.formatSpeed()
+	}
+	if p.ShowElapsedTime {
+		out += " | " + p.parseElapsedTime()
+	}
+	return out
+}
+
+// recordSample pushes a new (time, current) observation into the speed ring
+// buffer and updates the EWMA speed estimate. Callers must hold p.mu.
+func (p *ProgressbarPrinter) recordSample() {
+	now := time.Now()
+	p.samples = append(p.samples, progressSample{time: now, current: p.Current})
+	if len(p.samples) > progressSpeedSamples {
+		p.samples = p.samples[len(p.samples)-progressSpeedSamples:]
+	}
+	if len(p.samples) < 2 {
+		return
+	}
+
+	last := p.samples[len(p.samples)-1]
+	prev := p.samples[len(p.samples)-2]
+	elapsed := last.time.Sub(prev.time).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	instant := float64(last.current-prev.current) / elapsed
+
+	if p.speed == 0 {
+		first := p.samples[0]
+		totalElapsed := last.time.Sub(first.time).Seconds()
+		if totalElapsed > 0 {
+			p.speed = float64(last.current-first.current) / totalElapsed
+			return
+		}
+		p.speed = instant
+		return
+	}
+	p.speed = progressSpeedAlpha*instant + (1-progressSpeedAlpha)*p.speed
+}
+
+// GetSpeed returns the current estimated transfer rate, in units of Current
+// per second.
+func (p *ProgressbarPrinter) GetSpeed() float64 {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.speed
+}
+
+// GetETA returns the estimated remaining time until Current reaches Total,
+// based on the current speed estimate. It is zero once the bar is complete
+// or no speed estimate is available yet.
+func (p *ProgressbarPrinter) GetETA() time.Duration {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.getETA()
+}
+
+func (p *ProgressbarPrinter) getETA() time.Duration {
+	remaining := p.Total - p.Current
+	if remaining <= 0 || p.speed <= 0 {
+		return 0
+	}
+	return time.Duration(float64(remaining) / p.speed * float64(time.Second))
+}
+
+func (p *ProgressbarPrinter) formatSpeed() string {
+	return formatUnitValue(p.speed, p.Units) + "/s"
+}
+
+func (p *ProgressbarPrinter) formatETA() string {
+	if p.speed <= 0 {
+		return "--:--"
+	}
+	eta := p.getETA()
+	mins := int(eta.Minutes())
+	secs := int(eta.Seconds()) % 60
+	return fmt.Sprintf("%02d:%02d", mins, secs)
+}
+
+// formatUnitValue renders value according to units: a plain number with one
+// decimal place for UnitsDefault, mm:ss (value taken as seconds) for
+// UnitsDuration, IEC byte sizes for UnitsBytes, SI byte sizes for
+// UnitsBytesSI.
+func formatUnitValue(value float64, units Units) string {
+	switch units {
+	case UnitsBytes:
+		return humanizeBytes(value, 1024, []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"})
+	case UnitsBytesSI:
+		return humanizeBytes(value, 1000, []string{"B", "kB", "MB", "GB", "TB", "PB"})
+	case UnitsDuration:
+		d := time.Duration(value * float64(time.Second))
+		return fmt.Sprintf("%02d:%02d", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%.1f", value)
+	}
+}
+
+func humanizeBytes(value float64, base float64, suffixes []string) string {
+	if value < base {
+		return fmt.Sprintf("%.0f %s", value, suffixes[0])
+	}
+	exp := int(math.Log(value) / math.Log(base))
+	if exp >= len(suffixes) {
+		exp = len(suffixes) - 1
+	}
+	scaled := value / math.Pow(base, float64(exp))
+	return fmt.Sprintf("%.2f %s", scaled, suffixes[exp])
+}
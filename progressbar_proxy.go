@@ -0,0 +1,83 @@
+package pterm
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// progressbarProxyReader wraps an io.Reader and drives a ProgressbarPrinter
+// by calling p.Add(n) after every successful Read.
+type progressbarProxyReader struct {
+	r      io.Reader
+	p      *ProgressbarPrinter
+	closed int32
+}
+
+// NewProxyReader returns an io.ReadCloser wrapping r. Every successful Read
+// increments p's Current by the number of bytes read, so wrapping the body
+// of an http.Get response or a tar stream instruments it automatically.
+//
+// If p.Total is zero, the bar renders in an indeterminate mode (spinner,
+// bytes read and, with ShowSpeed, speed; no percentage or ETA), since the final size isn't
+// known. If p.StopOnEOF is set, p.Stop() is called as soon as r reports
+// io.EOF; Close always calls p.Stop() regardless. The returned reader is
+// safe to use concurrently with manual Add/Increment calls on p.
+func (p *ProgressbarPrinter) NewProxyReader(r io.Reader) io.ReadCloser {
+	return &progressbarProxyReader{r: r, p: p}
+}
+
+func (pr *progressbarProxyReader) Read(b []byte) (int, error) {
+	n, err := pr.r.Read(b)
+	if n > 0 {
+		pr.p.Add(n)
+	}
+	if err == io.EOF && pr.p.StopOnEOF {
+		_ = pr.Close()
+	}
+	return n, err
+}
+
+func (pr *progressbarProxyReader) Close() error {
+	if !atomic.CompareAndSwapInt32(&pr.closed, 0, 1) {
+		return nil
+	}
+	_, err := pr.p.Stop()
+	return err
+}
+
+// progressbarProxyWriter wraps an io.Writer and drives a ProgressbarPrinter
+// by calling p.Add(n) after every successful Write.
+type progressbarProxyWriter struct {
+	w      io.Writer
+	p      *ProgressbarPrinter
+	closed int32
+}
+
+// NewProxyWriter returns an io.WriteCloser wrapping w. Every successful
+// Write increments p's Current by the number of bytes written, which makes
+// instrumenting io.Copy destinations (file downloads, tar extraction)
+// trivial. See NewProxyReader for the indeterminate-mode and StopOnEOF
+// behavior; Close always calls p.Stop(). The returned writer is safe to use
+// concurrently with manual Add/Increment calls on p.
+func (p *ProgressbarPrinter) NewProxyWriter(w io.Writer) io.WriteCloser {
+	return &progressbarProxyWriter{w: w, p: p}
+}
+
+func (pw *progressbarProxyWriter) Write(b []byte) (int, error) {
+	n, err := pw.w.Write(b)
+	if n > 0 {
+		pw.p.Add(n)
+	}
+	if err == io.EOF && pw.p.StopOnEOF {
+		_ = pw.Close()
+	}
+	return n, err
+}
+
+func (pw *progressbarProxyWriter) Close() error {
+	if !atomic.CompareAndSwapInt32(&pw.closed, 0, 1) {
+		return nil
+	}
+	_, err := pw.p.Stop()
+	return err
+}
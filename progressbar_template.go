@@ -0,0 +1,253 @@
+package pterm
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/gookit/color"
+	"github.com/pterm/pterm/internal"
+)
+
+// Built-in Template values for ProgressbarPrinter. ProgressTemplateDefault
+// reproduces the classic hard-coded layout; ProgressTemplateFull adds speed
+// and ETA; ProgressTemplateBytes is tailored to byte-oriented transfers.
+const (
+	ProgressTemplateDefault = `{{if .Title}}{{.Title}} {{end}}{{counters .}} {{bar . "" "" "" "" ""}} {{percent .}} | {{etime .}}`
+	ProgressTemplateFull    = `{{if .Title}}{{.Title}} {{end}}{{counters .}} {{bar . "" "" "" "" ""}} {{percent .}} | {{speed .}} | {{rtime .}} | {{etime .}}`
+	ProgressTemplateBytes   = `{{if .Title}}{{.Title}} {{end}}{{counters .}} {{bar . "" "" "" "" ""}} {{speed .}} | {{rtime .}}`
+)
+
+// State exposes a ProgressbarPrinter's current values to a Template and to
+// functions registered via RegisterProgressTemplateFunc.
+type State struct {
+	Current int
+	Total   int
+	Elapsed time.Duration
+	Speed   float64
+	Width   int
+	Title   string
+	Units   Units
+
+	strings map[string]string
+}
+
+// stateETA estimates the remaining time to completion from s.Speed, zero
+// once complete or while no speed estimate is available.
+func stateETA(s State) time.Duration {
+	remaining := s.Total - s.Current
+	if remaining <= 0 || s.Speed <= 0 {
+		return 0
+	}
+	return time.Duration(float64(remaining) / s.Speed * float64(time.Second))
+}
+
+var (
+	progressTemplateFuncsMu sync.RWMutex
+	progressTemplateFuncs   = map[string]func(State) string{
+		"counters": func(s State) string {
+			if s.Units != UnitsDefault {
+				return Gray("[") + LightWhite(formatUnitValue(float64(s.Current), s.Units)) + Gray("/") + LightWhite(formatUnitValue(float64(s.Total), s.Units)) + Gray("]")
+			}
+			total := s.Total
+			if total < 1 {
+				total = 1
+			}
+			padding := 1 + int(math.Log10(float64(total)))
+			return Gray("[") + LightWhite(fmt.Sprintf("%0*d", padding, s.Current)) + Gray("/") + LightWhite(s.Total) + Gray("]")
+		},
+		"percent": func(s State) string {
+			currentPercentage := int(internal.PercentageRound(float64(int64(s.Total)), float64(int64(s.Current))))
+			return color.RGB(NewRGB(255, 0, 0).Fade(0, float32(s.Total), float32(s.Current), NewRGB(0, 255, 0)).GetValues()).
+				Sprintf("%3d%%", currentPercentage)
+		},
+		"speed": func(s State) string {
+			return formatUnitValue(s.Speed, s.Units) + "/s"
+		},
+		"etime": func(s State) string {
+			return s.Elapsed.Round(time.Second).String()
+		},
+		"rtime": func(s State) string {
+			if s.Speed <= 0 {
+				return "--:--"
+			}
+			eta := stateETA(s)
+			return fmt.Sprintf("%02d:%02d", int(eta.Minutes()), int(eta.Seconds())%60)
+		},
+	}
+)
+
+// RegisterProgressTemplateFunc makes fn available to ProgressbarPrinter
+// Templates under name, e.g. {{name .}}. Registering under an existing name
+// (including one of the built-ins) replaces it.
+func RegisterProgressTemplateFunc(name string, fn func(State) string) {
+	progressTemplateFuncsMu.Lock()
+	defer progressTemplateFuncsMu.Unlock()
+	progressTemplateFuncs[name] = fn
+}
+
+// progressBarSentinel delimits the encoded arguments of a {{bar}} call in a
+// first-pass template render, so they can be located and replaced with a
+// bar stretched to fill the remaining width once the rest of the line has
+// been measured.
+const progressBarSentinel = "\x00"
+
+// templateBarArgs encodes the bar's left/fill/cursor/empty/right strings so
+// they survive the first-pass template render.
+func templateBarArgs(chars ...string) string {
+	return progressBarSentinel + strings.Join(chars, "\x01") + progressBarSentinel
+}
+
+// templateBarChars are the characters used to render a {{bar}} decorator.
+type templateBarChars struct {
+	left, fill, cursor, empty, right string
+}
+
+func (p *ProgressbarPrinter) parseBarChars(parts []string) templateBarChars {
+	c := templateBarChars{
+		fill:   p.BarCharacter,
+		cursor: p.LastCharacter,
+		empty:  p.BarFiller,
+	}
+	if len(parts) > 0 && parts[0] != "" {
+		c.left = parts[0]
+	}
+	if len(parts) > 1 && parts[1] != "" {
+		c.fill = parts[1]
+	}
+	if len(parts) > 2 && parts[2] != "" {
+		c.cursor = parts[2]
+	}
+	if len(parts) > 3 && parts[3] != "" {
+		c.empty = parts[3]
+	}
+	if len(parts) > 4 && parts[4] != "" {
+		c.right = parts[4]
+	}
+	return c
+}
+
+// renderBar draws a bar of the given total width using c, filled in
+// proportion to p.Current/p.Total. Callers must hold p.mu.
+func (p *ProgressbarPrinter) renderBar(width int, c templateBarChars) string {
+	inner := width - len(RemoveColorFromString(c.left)) - len(RemoveColorFromString(c.right))
+	if inner < 0 {
+		inner = 0
+	}
+
+	filledLen := 0
+	if p.Total > 0 {
+		filledLen = (p.Current * inner) / p.Total
+	}
+	if filledLen > inner {
+		filledLen = inner
+	}
+
+	var filled string
+	switch {
+	case filledLen > 1:
+		filled = p.BarStyle.Sprint(strings.Repeat(c.fill, filledLen-1) + c.cursor)
+	case filledLen == 1:
+		filled = p.BarStyle.Sprint(c.cursor)
+	}
+
+	var empty string
+	if emptyLen := inner - filledLen; emptyLen > 0 {
+		empty = strings.Repeat(c.empty, emptyLen)
+	}
+
+	return c.left + filled + empty + c.right
+}
+
+// newProgressTemplate parses p.Template against the registered decorator
+// functions plus the two built-ins that need access to the printer itself:
+// bar (deferred, see progressBarSentinel) and string (reads Set values).
+func newProgressTemplate(text string) (*template.Template, error) {
+	funcMap := template.FuncMap{
+		"bar": func(s State, chars ...string) string {
+			return templateBarArgs(chars...)
+		},
+		"string": func(s State, key string) string {
+			return s.strings[key]
+		},
+	}
+
+	progressTemplateFuncsMu.RLock()
+	for name, fn := range progressTemplateFuncs {
+		funcMap[name] = fn
+	}
+	progressTemplateFuncsMu.RUnlock()
+
+	return template.New("progressbar").Funcs(funcMap).Parse(text)
+}
+
+// cachedTemplate returns the parsed form of text, reparsing and caching it
+// if text differs from what's cached. Guarded by its own mutex (rather than
+// p.mu) so that concurrent renders — getString only ever takes p.mu's read
+// lock — can parse/cache the template without racing each other.
+func (p *ProgressbarPrinter) cachedTemplate(text string) (*template.Template, error) {
+	p.templateMu.Lock()
+	defer p.templateMu.Unlock()
+
+	if p.parsedTemplate != nil && p.parsedTemplateText == text {
+		return p.parsedTemplate, nil
+	}
+
+	t, err := newProgressTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+	p.parsedTemplate = t
+	p.parsedTemplateText = text
+	return t, nil
+}
+
+// getTemplateString renders the ProgressbarPrinter using p.Template instead
+// of the built-in layout. Callers must hold p.mu (at least for reading).
+func (p *ProgressbarPrinter) getTemplateString(width int) string {
+	tmpl, err := p.cachedTemplate(p.Template)
+	if err != nil {
+		return fmt.Sprintf("invalid progressbar template: %s", err)
+	}
+
+	state := State{
+		Current: p.Current,
+		Total:   p.Total,
+		Elapsed: time.Since(p.startedAt).Round(p.ElapsedTimeRoundingFactor),
+		Speed:   p.speed,
+		Width:   width,
+		Title:   p.Title,
+		Units:   p.Units,
+		strings: p.userStrings,
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, state); err != nil {
+		return fmt.Sprintf("invalid progressbar template: %s", err)
+	}
+	rendered := buf.String()
+
+	idx := strings.Index(rendered, progressBarSentinel)
+	if idx < 0 {
+		return rendered
+	}
+	rest := rendered[idx+len(progressBarSentinel):]
+	end := strings.Index(rest, progressBarSentinel)
+	if end < 0 {
+		return rendered
+	}
+
+	before := rendered[:idx]
+	after := rest[end+len(progressBarSentinel):]
+	chars := p.parseBarChars(strings.Split(rest[:end], "\x01"))
+
+	barWidth := width - len(RemoveColorFromString(before)) - len(RemoveColorFromString(after))
+	if barWidth < 0 {
+		barWidth = 0
+	}
+
+	return before + p.renderBar(barWidth, chars) + after
+}